@@ -7,77 +7,549 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/facebookgo/inject"
 )
 
 // Starter defines the Start method. Objects satisfying this interface will be
-// started by Start
+// started by Start/StartContext/StartParallel.
 type Starter interface {
 	Start(context.Context) error
 }
 
 // Stopper defines the Stop method, objects satisfying this interface will be
-// stopped by Stop.
+// stopped by Stop/StopContext/StopParallel.
 type Stopper interface {
 	Stop(context.Context) error
 }
 
+// Healthchecker defines the Health method. Objects satisfying this
+// interface will be checked by Healthcheck.
+type Healthchecker interface {
+	Health(ctx context.Context) error
+}
+
 // Logger is used by Start/Stop to provide debug and error logging.
 type Logger interface {
 	Debugf(f string, args ...interface{})
 	Errorf(f string, args ...interface{})
 }
 
-// Start starts the graph, in the right order. Start will call Start if an
-// object satisfies the associated interface.
+// Level identifies the severity of a StructuredLogger entry.
+type Level int
+
+const (
+	// LevelDebug corresponds to Logger.Debugf.
+	LevelDebug Level = iota
+	// LevelError corresponds to Logger.Errorf.
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Field is a single structured logging key/value pair, passed to
+// StructuredLogger.Log.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Well-known Field keys emitted by Start/Stop/Healthcheck.
+const (
+	FieldPhase      = "phase"
+	FieldObject     = "object"
+	FieldDurationMS = "duration_ms"
+	FieldError      = "error"
+)
+
+// StructuredLogger is an optional, richer alternative to Logger. When a
+// Logger passed to Start/Stop/Healthcheck also satisfies StructuredLogger,
+// it is preferred, and phase/object/duration_ms/error are passed as typed
+// Fields instead of being baked into a printf string. This makes
+// correlating startup/shutdown events easy in structured backends
+// (Honeycomb, zap, logrus, zerolog, ...).
+type StructuredLogger interface {
+	Log(ctx context.Context, level Level, msg string, fields ...Field)
+}
+
+// logTo logs msg through log, preferring log.Log if log satisfies
+// StructuredLogger and falling back to Debugf/Errorf otherwise. This is how
+// Start/Stop/Healthcheck log internally, so a StructuredLogger is used
+// whenever one is available without requiring any other code changes.
+func logTo(ctx context.Context, log Logger, level Level, msg string, fields ...Field) {
+	if log == nil {
+		return
+	}
+	if sl, ok := log.(StructuredLogger); ok {
+		sl.Log(ctx, level, msg, fields...)
+		return
+	}
+	switch level {
+	case LevelError:
+		log.Errorf("%s", msg)
+	default:
+		log.Debugf("%s", msg)
+	}
+}
+
+// logStructured is like logTo, but is a no-op unless log satisfies
+// StructuredLogger. It's used for events, like duration_ms on a successful
+// start/stop, that a plain Logger has no printf-friendly equivalent for and
+// so has never logged.
+func logStructured(ctx context.Context, log Logger, level Level, msg string, fields ...Field) {
+	if log == nil {
+		return
+	}
+	if sl, ok := log.(StructuredLogger); ok {
+		sl.Log(ctx, level, msg, fields...)
+	}
+}
+
+// Options controls how StartContextOptions/StopContextOptions process the
+// object graph. The zero value runs levels serially with no per-object
+// timeout, matching StartContext/StopContext.
+type Options struct {
+	// Parallel runs every eligible object within a dependency level
+	// concurrently instead of serially.
+	Parallel bool
+
+	// StartTimeout, if non-zero, bounds how long a single Starter's
+	// Start call is given to return before it is treated as failed.
+	StartTimeout time.Duration
+
+	// StopTimeout, if non-zero, bounds how long a single Stopper's
+	// Stop call is given to return before it is treated as failed.
+	StopTimeout time.Duration
+}
+
+// Start starts the graph, in the right order. Start will call Start on an
+// object if it satisfies the Starter interface.
+//
+// Start is an alias for StartContext, kept for backwards compatibility.
 func Start(ctx context.Context, objects []*inject.Object, log Logger) error {
+	return StartContext(ctx, objects, log)
+}
+
+// StartContext starts the graph, in the right order. StartContext will call
+// Start on an object if it satisfies the Starter interface.
+func StartContext(ctx context.Context, objects []*inject.Object, log Logger) error {
+	return StartContextOptions(ctx, objects, log, Options{})
+}
+
+// StartParallel starts the graph, in the right order, like StartContext, but
+// starts every Starter within a single dependency level concurrently instead
+// of serially. Levels themselves are still processed one at a time, waiting
+// for the previous level to finish before the next one begins.
+//
+// As soon as any object in a level returns an error, the context passed to
+// the remaining Starters in that level is canceled, and StartParallel
+// returns the first error it saw once every other call in the level has
+// either returned or seen that cancellation. It does not wait for a
+// Starter that ignores cancellation to return.
+func StartParallel(ctx context.Context, objects []*inject.Object, log Logger) error {
+	return StartContextOptions(ctx, objects, log, Options{Parallel: true})
+}
+
+// StartContextOptions starts the graph, in the right order, the same as
+// StartContext, but honors the per-object and concurrency behavior
+// described by opts. A non-zero opts.StartTimeout bounds how long each
+// Starter is given to return; a Starter that exceeds it fails with a
+// wrapped error naming the object. Cancellation of ctx itself is also
+// honored at level boundaries, so it can short-circuit an in-progress
+// startup between levels.
+func StartContextOptions(ctx context.Context, objects []*inject.Object, log Logger, opts Options) error {
 	levels, err := levels(objects)
 	if err != nil {
 		return err
 	}
 
 	for i := len(levels) - 1; i >= 0; i-- {
-		level := levels[i]
-		for _, o := range level {
-			if starterO, ok := o.Value.(Starter); ok {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 
-				if log != nil {
-					log.Debugf("starting %s", o)
-				}
-				if err := starterO.Start(ctx); err != nil {
-					return err
-				}
+		level := levels[i]
+		if opts.Parallel {
+			if err := startLevelParallel(ctx, level, log, opts.StartTimeout); err != nil {
+				return err
 			}
+		} else if err := startLevelSerial(ctx, level, log, opts.StartTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startLevelSerial(ctx context.Context, level []*inject.Object, log Logger, timeout time.Duration) error {
+	for _, o := range level {
+		starterO, ok := o.Value.(Starter)
+		if !ok {
+			continue
+		}
+
+		logTo(ctx, log, LevelDebug, fmt.Sprintf("starting %s", o),
+			Field{FieldPhase, "start"}, Field{FieldObject, fmt.Sprint(o)})
+
+		began := time.Now()
+		err := callWithTimeout(ctx, timeout, starterO.Start)
+		duration := time.Since(began)
+		if err != nil {
+			err = wrapTimeout("starting", o, err)
+			logStructured(ctx, log, LevelError, fmt.Sprintf("error starting %s: %s", o, err),
+				Field{FieldPhase, "start"}, Field{FieldObject, fmt.Sprint(o)},
+				Field{FieldDurationMS, duration.Milliseconds()}, Field{FieldError, err.Error()})
+			return err
 		}
+		logStructured(ctx, log, LevelDebug, fmt.Sprintf("started %s", o),
+			Field{FieldPhase, "start"}, Field{FieldObject, fmt.Sprint(o)}, Field{FieldDurationMS, duration.Milliseconds()})
 	}
 	return nil
 }
 
-// Stop stops the graph, in the right order. Stop will call Stop if an
-// object satisfies the associated interface. Unlike Start(), logs and
-// continues if a Stop call returns an error.
+func startLevelParallel(ctx context.Context, level []*inject.Object, log Logger, timeout time.Duration) error {
+	levelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for _, o := range level {
+		starterO, ok := o.Value.(Starter)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(o *inject.Object, starterO Starter) {
+			defer wg.Done()
+
+			mu.Lock()
+			logTo(ctx, log, LevelDebug, fmt.Sprintf("starting %s", o),
+				Field{FieldPhase, "start"}, Field{FieldObject, fmt.Sprint(o)})
+			mu.Unlock()
+
+			began := time.Now()
+			err := callWithTimeout(levelCtx, timeout, starterO.Start)
+			duration := time.Since(began)
+
+			mu.Lock()
+			if err != nil {
+				err = wrapTimeout("starting", o, err)
+				logStructured(ctx, log, LevelError, fmt.Sprintf("error starting %s: %s", o, err),
+					Field{FieldPhase, "start"}, Field{FieldObject, fmt.Sprint(o)},
+					Field{FieldDurationMS, duration.Milliseconds()}, Field{FieldError, err.Error()})
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+			} else {
+				logStructured(ctx, log, LevelDebug, fmt.Sprintf("started %s", o),
+					Field{FieldPhase, "start"}, Field{FieldObject, fmt.Sprint(o)}, Field{FieldDurationMS, duration.Milliseconds()})
+			}
+			mu.Unlock()
+		}(o, starterO)
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// Stop stops the graph, in the right order. Stop will call Stop on an object
+// if it satisfies the Stopper interface. Unlike Start(), logs and continues
+// if a Stop call returns an error.
+//
+// Stop is an alias for StopContext, kept for backwards compatibility.
 func Stop(ctx context.Context, objects []*inject.Object, log Logger) error {
+	return StopContext(ctx, objects, log)
+}
+
+// StopContext stops the graph, in the right order. StopContext will call
+// Stop on an object if it satisfies the Stopper interface. Unlike
+// StartContext(), logs and continues if a Stop call returns an error. Any
+// failures are also collected and returned as a *StopError once every
+// object has had a chance to stop.
+func StopContext(ctx context.Context, objects []*inject.Object, log Logger) error {
+	return StopContextOptions(ctx, objects, log, Options{})
+}
+
+// StopParallel stops the graph, in the right order, like StopContext, but
+// stops every Stopper within a single dependency level concurrently instead
+// of serially. Like StopContext, it logs and continues within a level if a
+// Stop call returns an error; it does not cancel siblings on error, since
+// shutdown must always run to completion. Failures are collected and
+// returned as a *StopError, the same as StopContext.
+func StopParallel(ctx context.Context, objects []*inject.Object, log Logger) error {
+	return StopContextOptions(ctx, objects, log, Options{Parallel: true})
+}
+
+// StopContextOptions stops the graph, in the right order, the same as
+// StopContext, but honors the per-object and concurrency behavior described
+// by opts. A non-zero opts.StopTimeout bounds how long each Stopper is
+// given to return; a Stopper that exceeds it is logged and recorded as a
+// failure the same as any other Stop error, and shutdown proceeds to the
+// next component regardless.
+func StopContextOptions(ctx context.Context, objects []*inject.Object, log Logger, opts Options) error {
 	levels, err := levels(objects)
 	if err != nil {
 		return err
 	}
 
+	var failures []StopFailure
+	for _, level := range levels {
+		if opts.Parallel {
+			failures = append(failures, stopLevelParallel(ctx, level, log, opts.StopTimeout)...)
+		} else {
+			failures = append(failures, stopLevelSerial(ctx, level, log, opts.StopTimeout)...)
+		}
+	}
+	return stopError(failures)
+}
+
+func stopLevelSerial(ctx context.Context, level []*inject.Object, log Logger, timeout time.Duration) []StopFailure {
+	var failures []StopFailure
+	for _, o := range level {
+		stopperO, ok := o.Value.(Stopper)
+		if !ok {
+			continue
+		}
+
+		logTo(ctx, log, LevelDebug, fmt.Sprintf("stopping %s", o),
+			Field{FieldPhase, "stop"}, Field{FieldObject, fmt.Sprint(o)})
+
+		began := time.Now()
+		err := callWithTimeout(ctx, timeout, stopperO.Stop)
+		duration := time.Since(began)
+		if err != nil {
+			err = wrapTimeout("stopping", o, err)
+			logTo(ctx, log, LevelError, fmt.Sprintf("error stopping %s: %s", o, err),
+				Field{FieldPhase, "stop"}, Field{FieldObject, fmt.Sprint(o)},
+				Field{FieldDurationMS, duration.Milliseconds()}, Field{FieldError, err.Error()})
+			failures = append(failures, StopFailure{Object: o, Err: err})
+			continue
+		}
+		logStructured(ctx, log, LevelDebug, fmt.Sprintf("stopped %s", o),
+			Field{FieldPhase, "stop"}, Field{FieldObject, fmt.Sprint(o)}, Field{FieldDurationMS, duration.Milliseconds()})
+	}
+	return failures
+}
+
+func stopLevelParallel(ctx context.Context, level []*inject.Object, log Logger, timeout time.Duration) []StopFailure {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failures []StopFailure
+
+	for _, o := range level {
+		stopperO, ok := o.Value.(Stopper)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(o *inject.Object, stopperO Stopper) {
+			defer wg.Done()
+
+			mu.Lock()
+			logTo(ctx, log, LevelDebug, fmt.Sprintf("stopping %s", o),
+				Field{FieldPhase, "stop"}, Field{FieldObject, fmt.Sprint(o)})
+			mu.Unlock()
+
+			began := time.Now()
+			err := callWithTimeout(ctx, timeout, stopperO.Stop)
+			duration := time.Since(began)
+
+			mu.Lock()
+			if err != nil {
+				err = wrapTimeout("stopping", o, err)
+				logTo(ctx, log, LevelError, fmt.Sprintf("error stopping %s: %s", o, err),
+					Field{FieldPhase, "stop"}, Field{FieldObject, fmt.Sprint(o)},
+					Field{FieldDurationMS, duration.Milliseconds()}, Field{FieldError, err.Error()})
+				failures = append(failures, StopFailure{Object: o, Err: err})
+			} else {
+				logStructured(ctx, log, LevelDebug, fmt.Sprintf("stopped %s", o),
+					Field{FieldPhase, "stop"}, Field{FieldObject, fmt.Sprint(o)}, Field{FieldDurationMS, duration.Milliseconds()})
+			}
+			mu.Unlock()
+		}(o, stopperO)
+	}
+
+	wg.Wait()
+	return failures
+}
+
+// callWithTimeout invokes fn with ctx, bounding it by timeout if non-zero.
+// Unlike a plain context.WithTimeout, it returns as soon as the deadline (or
+// ctx's own cancellation) fires rather than waiting for fn itself to notice
+// and return, so a fn that ignores ctx cannot hang the caller.
+//
+// Known caveat: this only unblocks the caller. If fn ignores ctx and never
+// returns, the goroutine running it (and anything it holds) leaks for the
+// lifetime of the process. Starter/Stopper implementations must still obey
+// ctx cancellation promptly to avoid leaking.
+func callWithTimeout(ctx context.Context, timeout time.Duration, fn func(context.Context) error) error {
+	callCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(callCtx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-callCtx.Done():
+		return callCtx.Err()
+	}
+}
+
+// wrapTimeout names the object that timed out or was canceled, leaving
+// every other error untouched so callers can still compare against the
+// original error value. DeadlineExceeded and Canceled are worded
+// differently: DeadlineExceeded means this object's own timeout fired,
+// while Canceled means the object was aborted because something else
+// (e.g. a sibling failing in startLevelParallel, or the caller) caused
+// the context to be canceled before this object ever got a chance to
+// finish. Conflating the two misleads an operator into thinking the
+// aborted object hung when it never ran past cancellation.
+func wrapTimeout(verb string, o *inject.Object, err error) error {
+	switch err {
+	case context.DeadlineExceeded:
+		return fmt.Errorf("timed out %s %s: %w", verb, o, err)
+	case context.Canceled:
+		return fmt.Errorf("canceled %s %s: %w", verb, o, err)
+	default:
+		return err
+	}
+}
+
+// StopFailure records a single object's failure to stop, as collected by
+// StopContext/StopParallel into a StopError.
+type StopFailure struct {
+	Object *inject.Object
+	Err    error
+}
+
+// StopError is returned by Stop/StopContext/StopParallel when one or more
+// objects failed to stop. It implements Unwrap() []error so individual
+// failures can be inspected with errors.Is/errors.As.
+type StopError struct {
+	Failures []StopFailure
+}
+
+func (e *StopError) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d object(s) failed to stop:", len(e.Failures))
+	for _, f := range e.Failures {
+		fmt.Fprintf(&buf, "\n  %s: %s", f.Object, f.Err)
+	}
+	return buf.String()
+}
+
+func (e *StopError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// stopError returns nil if there are no failures, so callers can keep
+// treating a clean stop as a nil error.
+func stopError(failures []StopFailure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	return &StopError{Failures: failures}
+}
+
+// Healthcheck walks the same dependency graph as Start/Stop and calls
+// Health on every object that implements Healthchecker, so a readiness or
+// liveness endpoint can be wired against the exact graph already registered
+// with inject, without duplicating object bookkeeping. Failures are
+// collected and returned as a *HealthcheckError, the same way StopContext
+// aggregates failures.
+func Healthcheck(ctx context.Context, objects []*inject.Object, log Logger) error {
+	levels, err := levels(objects)
+	if err != nil {
+		return err
+	}
+
+	var failures []HealthcheckFailure
 	for _, level := range levels {
 		for _, o := range level {
-			if stopperO, ok := o.Value.(Stopper); ok {
-				if log != nil {
-					log.Debugf("stopping %s", o)
-				}
-				if err := stopperO.Stop(ctx); err != nil {
-					if log != nil {
-						log.Errorf("error stopping %s: %s", o, err)
-					}
-				}
+			healthO, ok := o.Value.(Healthchecker)
+			if !ok {
+				continue
 			}
+
+			logTo(ctx, log, LevelDebug, fmt.Sprintf("checking health of %s", o),
+				Field{FieldPhase, "health"}, Field{FieldObject, fmt.Sprint(o)})
+
+			began := time.Now()
+			err := healthO.Health(ctx)
+			duration := time.Since(began)
+			if err != nil {
+				logTo(ctx, log, LevelError, fmt.Sprintf("error checking health of %s: %s", o, err),
+					Field{FieldPhase, "health"}, Field{FieldObject, fmt.Sprint(o)},
+					Field{FieldDurationMS, duration.Milliseconds()}, Field{FieldError, err.Error()})
+				failures = append(failures, HealthcheckFailure{Object: o, Err: err})
+				continue
+			}
+			logStructured(ctx, log, LevelDebug, fmt.Sprintf("healthy %s", o),
+				Field{FieldPhase, "health"}, Field{FieldObject, fmt.Sprint(o)}, Field{FieldDurationMS, duration.Milliseconds()})
 		}
 	}
-	return nil
+	if len(failures) == 0 {
+		return nil
+	}
+	return &HealthcheckError{Failures: failures}
+}
+
+// HealthcheckFailure records a single object's failed health check, as
+// collected by Healthcheck into a HealthcheckError.
+type HealthcheckFailure struct {
+	Object *inject.Object
+	Err    error
+}
+
+// HealthcheckError is returned by Healthcheck when one or more objects
+// failed their health check. It implements Unwrap() []error so individual
+// failures can be inspected with errors.Is/errors.As.
+type HealthcheckError struct {
+	Failures []HealthcheckFailure
+}
+
+func (e *HealthcheckError) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d object(s) failed health check:", len(e.Failures))
+	for _, f := range e.Failures {
+		fmt.Fprintf(&buf, "\n  %s: %s", f.Object, f.Err)
+	}
+	return buf.String()
+}
+
+func (e *HealthcheckError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
 }
 
 // levels returns a slice of levels of objects of the Object Graph that
@@ -193,5 +665,8 @@ func isEligible(i *inject.Object) bool {
 	if _, ok := i.Value.(Stopper); ok {
 		return true
 	}
+	if _, ok := i.Value.(Healthchecker); ok {
+		return true
+	}
 	return false
 }
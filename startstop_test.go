@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/facebookarchive/ensure"
 	"github.com/facebookgo/inject"
@@ -31,11 +32,11 @@ type startStop struct {
 	stop  func(context.Context) error
 }
 
-func (s *startStop) StartContext(ctx context.Context) error {
+func (s *startStop) Start(ctx context.Context) error {
 	return s.start(ctx)
 }
 
-func (s *startStop) StopContext(ctx context.Context) error {
+func (s *startStop) Stop(ctx context.Context) error {
 	return s.stop(ctx)
 }
 
@@ -45,11 +46,11 @@ type startStop2 struct {
 	stop      func(context.Context) error
 }
 
-func (s *startStop2) StartContext(ctx context.Context) error {
+func (s *startStop2) Start(ctx context.Context) error {
 	return s.start(ctx)
 }
 
-func (s *startStop2) StopContext(ctx context.Context) error {
+func (s *startStop2) Stop(ctx context.Context) error {
 	return s.stop(ctx)
 }
 
@@ -136,7 +137,14 @@ func TestStopError(t *testing.T) {
 	))
 	ensure.Nil(t, g.Populate())
 	ensure.Nil(t, startstop.StartContext(context.Background(), g.Objects(), logger))
-	ensure.Nil(t, startstop.StopContext(context.Background(), g.Objects(), logger))
+
+	stopErr := startstop.StopContext(context.Background(), g.Objects(), logger)
+	var stopError *startstop.StopError
+	ensure.True(t, errors.As(stopErr, &stopError))
+	ensure.DeepEqual(t, len(stopError.Failures), 1)
+	ensure.DeepEqual(t, stopError.Failures[0].Object.Value, obj2)
+	ensure.DeepEqual(t, stopError.Failures[0].Err, actual)
+
 	ensure.DeepEqual(t, logger.debugs, []string{
 		"starting *startstop_test.startStop",
 		"starting *startstop_test.startStop2",
@@ -147,6 +155,87 @@ func TestStopError(t *testing.T) {
 	ensure.True(t, stopped)
 }
 
+func TestStopErrorUnwrap(t *testing.T) {
+	actual1 := errors.New("err1")
+	actual2 := errors.New("err2")
+	stopErr := &startstop.StopError{
+		Failures: []startstop.StopFailure{
+			{Err: actual1},
+			{Err: actual2},
+		},
+	}
+
+	ensure.True(t, errors.Is(stopErr, actual1))
+	ensure.True(t, errors.Is(stopErr, actual2))
+	ensure.False(t, errors.Is(stopErr, errors.New("err3")))
+}
+
+func TestStartContextOptionsTimeout(t *testing.T) {
+	hang := make(chan struct{})
+	defer close(hang)
+
+	obj := &startStop{
+		start: func(ctx context.Context) error {
+			<-hang
+			return nil
+		},
+	}
+
+	err := startstop.StartContextOptions(
+		context.Background(),
+		[]*inject.Object{{Value: obj}},
+		nil,
+		startstop.Options{StartTimeout: time.Millisecond},
+	)
+	ensure.NotNil(t, err)
+	ensure.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestStopContextOptionsTimeout(t *testing.T) {
+	hang := make(chan struct{})
+	defer close(hang)
+
+	obj := &startStop{
+		start: func(ctx context.Context) error { return nil },
+		stop: func(ctx context.Context) error {
+			<-hang
+			return nil
+		},
+	}
+
+	err := startstop.StopContextOptions(
+		context.Background(),
+		[]*inject.Object{{Value: obj}},
+		nil,
+		startstop.Options{StopTimeout: time.Millisecond},
+	)
+
+	var stopError *startstop.StopError
+	ensure.True(t, errors.As(err, &stopError))
+	ensure.DeepEqual(t, len(stopError.Failures), 1)
+	ensure.True(t, errors.Is(stopError.Failures[0].Err, context.DeadlineExceeded))
+}
+
+func TestStartContextOptionsHonorsCanceledContext(t *testing.T) {
+	obj := &startStop{
+		start: func(ctx context.Context) error {
+			t.Fatal("should not get called")
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := startstop.StartContextOptions(
+		ctx,
+		[]*inject.Object{{Value: obj}},
+		nil,
+		startstop.Options{},
+	)
+	ensure.DeepEqual(t, err, context.Canceled)
+}
+
 func TestStartOrder(t *testing.T) {
 	res := make(chan int, 2)
 	obj1 := &startStop{
@@ -176,19 +265,135 @@ func TestStartOrder(t *testing.T) {
 	ensure.DeepEqual(t, <-res, 2)
 }
 
+func TestStartParallelOrder(t *testing.T) {
+	res := make(chan int, 2)
+	obj1 := &startStop{
+		start: func(ctx context.Context) error {
+			defer func() { res <- 1 }()
+			return nil
+		},
+	}
+	obj2 := &startStop2{
+		start: func(ctx context.Context) error {
+			defer func() { res <- 2 }()
+			return nil
+		},
+	}
+
+	var g inject.Graph
+	ensure.Nil(
+		t,
+		g.Provide(
+			&inject.Object{Value: obj1},
+			&inject.Object{Value: obj2},
+		),
+	)
+	ensure.Nil(t, g.Populate())
+	ensure.Nil(t, startstop.StartParallel(context.Background(), g.Objects(), nil))
+	ensure.DeepEqual(t, <-res, 1)
+	ensure.DeepEqual(t, <-res, 2)
+}
+
+// TestStartParallelConcurrency relies on every object in the level blocking
+// until all of its siblings have arrived; if StartParallel ran the level
+// serially instead of concurrently, this would deadlock and the test would
+// time out.
+func TestStartParallelConcurrency(t *testing.T) {
+	const n = 10
+	var arrived sync.WaitGroup
+	arrived.Add(n)
+
+	var objects []*inject.Object
+	for i := 0; i < n; i++ {
+		objects = append(objects, &inject.Object{Value: &startStop{
+			start: func(ctx context.Context) error {
+				arrived.Done()
+				arrived.Wait()
+				return nil
+			},
+		}})
+	}
+
+	ensure.Nil(t, startstop.StartParallel(context.Background(), objects, nil))
+}
+
+func TestStartParallelErrorCancelsSiblings(t *testing.T) {
+	actual := errors.New("err")
+	cancelled := make(chan struct{})
+
+	failing := &startStop{
+		start: func(ctx context.Context) error {
+			return actual
+		},
+	}
+	sibling := &startStop{
+		start: func(ctx context.Context) error {
+			<-ctx.Done()
+			close(cancelled)
+			return nil
+		},
+	}
+
+	objects := []*inject.Object{
+		{Value: failing},
+		{Value: sibling},
+	}
+
+	ensure.DeepEqual(t, startstop.StartParallel(context.Background(), objects, nil), actual)
+	<-cancelled
+}
+
+func TestStopParallelContinuesOnError(t *testing.T) {
+	var stopped bool
+	actual := errors.New("err")
+	obj1 := &startStop{
+		start: func(ctx context.Context) error { return nil },
+		stop: func(ctx context.Context) error {
+			stopped = true
+			return nil
+		},
+	}
+	obj2 := &startStop2{
+		start: func(ctx context.Context) error { return nil },
+		stop: func(ctx context.Context) error {
+			return actual
+		},
+	}
+	logger := &testLogger{}
+
+	var g inject.Graph
+	ensure.Nil(t, g.Provide(
+		&inject.Object{Value: obj1},
+		&inject.Object{Value: obj2},
+	))
+	ensure.Nil(t, g.Populate())
+	objects := g.Objects()
+
+	ensure.Nil(t, startstop.StartParallel(context.Background(), objects, nil))
+
+	stopErr := startstop.StopParallel(context.Background(), objects, logger)
+	var stopError *startstop.StopError
+	ensure.True(t, errors.As(stopErr, &stopError))
+	ensure.DeepEqual(t, len(stopError.Failures), 1)
+	ensure.DeepEqual(t, stopError.Failures[0].Err, actual)
+
+	ensure.True(t, stopped)
+	ensure.DeepEqual(t, logger.errors, []string{"error stopping *startstop_test.startStop2: err"})
+}
+
 type caseStartStop struct {
 	Name      string
 	ValidCase *ValidCase
 }
 
-func (c *caseStartStop) StartContext(ctx context.Context) error {
+func (c *caseStartStop) Start(ctx context.Context) error {
 	c.ValidCase.mutex.Lock()
 	defer c.ValidCase.mutex.Unlock()
 	c.ValidCase.actualStart = append(c.ValidCase.actualStart, c.Name)
 	return nil
 }
 
-func (c *caseStartStop) StopContext(ctx context.Context) error {
+func (c *caseStartStop) Stop(ctx context.Context) error {
 	c.ValidCase.mutex.Lock()
 	defer c.ValidCase.mutex.Unlock()
 	c.ValidCase.actualStop = append(c.ValidCase.actualStop, c.Name)
@@ -568,7 +773,7 @@ type startButNoStop struct {
 	start func(context.Context) error
 }
 
-func (s *startButNoStop) StartContext(ctx context.Context) error {
+func (s *startButNoStop) Start(ctx context.Context) error {
 	return s.start(ctx)
 }
 
@@ -576,7 +781,7 @@ type stopButNoStart struct {
 	stop func(context.Context) error
 }
 
-func (s *stopButNoStart) StopContext(ctx context.Context) error {
+func (s *stopButNoStart) Stop(ctx context.Context) error {
 	return s.stop(ctx)
 }
 
@@ -610,3 +815,145 @@ func TestOneHalfOnly(t *testing.T) {
 	ensure.DeepEqual(t, <-res, 1)
 	ensure.DeepEqual(t, <-res, 2)
 }
+
+type healthOnly struct {
+	health func(context.Context) error
+}
+
+func (h *healthOnly) Health(ctx context.Context) error {
+	return h.health(ctx)
+}
+
+func TestHealthcheck(t *testing.T) {
+	var checked []string
+	var mu sync.Mutex
+
+	dep := &healthOnly{
+		health: func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			checked = append(checked, "dep")
+			return nil
+		},
+	}
+
+	var g inject.Graph
+	ensure.Nil(t, g.Provide(
+		&inject.Object{Value: dep},
+	))
+	ensure.Nil(t, g.Populate())
+	ensure.Nil(t, startstop.Healthcheck(context.Background(), g.Objects(), nil))
+	ensure.DeepEqual(t, checked, []string{"dep"})
+}
+
+func TestHealthcheckAggregatesFailures(t *testing.T) {
+	actual := errors.New("unhealthy")
+	healthy := &healthOnly{health: func(context.Context) error { return nil }}
+	unhealthy := &healthOnly{health: func(context.Context) error { return actual }}
+	logger := &testLogger{}
+
+	objects := []*inject.Object{
+		{Value: healthy},
+		{Value: unhealthy},
+	}
+
+	err := startstop.Healthcheck(context.Background(), objects, logger)
+	var healthErr *startstop.HealthcheckError
+	ensure.True(t, errors.As(err, &healthErr))
+	ensure.DeepEqual(t, len(healthErr.Failures), 1)
+	ensure.DeepEqual(t, healthErr.Failures[0].Err, actual)
+	ensure.True(t, errors.Is(err, actual))
+}
+
+// (A) ↩ — a Healthchecker-only self-reference is still a cycle.
+func TestHealthcheckSelfDependentCycle(t *testing.T) {
+	a := &inject.Object{Value: &healthOnly{health: func(context.Context) error { return nil }}}
+	a.Fields = map[string]*inject.Object{"A": a}
+
+	objects := []*inject.Object{a}
+	err := startstop.Healthcheck(context.Background(), objects, nil)
+	ensure.NotNil(t, err)
+	ensure.True(t, strings.Contains(err.Error(), "circular reference detected"))
+}
+
+type logEntry struct {
+	level  startstop.Level
+	msg    string
+	fields map[string]interface{}
+}
+
+type structuredTestLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (s *structuredTestLogger) Log(ctx context.Context, level startstop.Level, msg string, fields ...startstop.Field) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fieldMap := map[string]interface{}{}
+	for _, f := range fields {
+		fieldMap[f.Key] = f.Value
+	}
+	s.entries = append(s.entries, logEntry{level: level, msg: msg, fields: fieldMap})
+}
+
+// Debugf and Errorf satisfy startstop.Logger so a structuredTestLogger can be
+// passed anywhere a Logger is accepted; StartContext/StopContext prefer Log
+// when it's available, so these should never be called in practice.
+func (s *structuredTestLogger) Debugf(f string, args ...interface{}) {}
+func (s *structuredTestLogger) Errorf(f string, args ...interface{}) {}
+
+func (s *structuredTestLogger) byPhase(phase string) []logEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []logEntry
+	for _, e := range s.entries {
+		if e.fields[startstop.FieldPhase] == phase {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func TestStructuredLoggerPreferred(t *testing.T) {
+	actual := errors.New("err")
+	obj1 := &startStop{
+		start: func(ctx context.Context) error { return nil },
+		stop:  func(ctx context.Context) error { return nil },
+	}
+	obj2 := &startStop{
+		start: func(ctx context.Context) error { return nil },
+		stop:  func(ctx context.Context) error { return actual },
+	}
+	logger := &structuredTestLogger{}
+
+	objects := []*inject.Object{{Value: obj1}, {Value: obj2}}
+	ensure.Nil(t, startstop.StartContext(context.Background(), objects, logger))
+
+	started := logger.byPhase("start")
+	ensure.DeepEqual(t, len(started), 4)
+	for _, e := range started {
+		ensure.DeepEqual(t, e.level, startstop.LevelDebug)
+		ensure.True(t, e.fields[startstop.FieldObject] != nil)
+	}
+
+	stopErr := startstop.StopContext(context.Background(), objects, logger)
+	ensure.NotNil(t, stopErr)
+
+	stopped := logger.byPhase("stop")
+
+	var sawError, sawDuration int
+	for _, e := range stopped {
+		if e.level == startstop.LevelError {
+			sawError++
+			ensure.DeepEqual(t, e.fields[startstop.FieldError], actual.Error())
+		}
+		if e.fields[startstop.FieldDurationMS] != nil {
+			sawDuration++
+		}
+	}
+	ensure.DeepEqual(t, sawError, 1)
+	ensure.DeepEqual(t, sawDuration, 2)
+}